@@ -0,0 +1,6 @@
+// Package apiv1 holds the generated protobuf/gRPC code for asmd.proto: the
+// StateMachine message family and the Generator service. Everything here
+// but this file is generated; run `go generate` after editing asmd.proto.
+package apiv1
+
+//go:generate protoc -I . --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative asmd.proto
@@ -4,10 +4,10 @@ import (
 	"encoding/json"
 	"errors"
 	"io/ioutil"
-	"os"
-	"strconv"
 	"strings"
-	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
 )
 
 type StateMachine struct {
@@ -17,8 +17,22 @@ type StateMachine struct {
 	Parameters      map[string]Variable
 	Registers       map[string]Variable
 	FunctionalUnits map[string]FunctionalUnit
-	//States map[string]State
-	//Conditions map[string]Condition
+	States          map[string]State
+	Conditions      map[string]Condition
+
+	// Logger receives structured Debug/Info/Warn/Error records from
+	// Validate, FixUpWithDefaults, RunPasses, and Generate. Nil (the JSON/
+	// proto-decoded zero value) means discard everything; use
+	// ParseWithLogger or set this field directly to capture them.
+	Logger log.Logger `json:"-"`
+}
+
+// logger returns m.Logger, or a no-op logger if it hasn't been set.
+func (m *StateMachine) logger() log.Logger {
+	if m.Logger == nil {
+		return log.NewNopLogger()
+	}
+	return m.Logger
 }
 
 type Options struct {
@@ -29,6 +43,42 @@ type Options struct {
 	FirstState        string // must be in States
 	Indent            string // default four spaces
 	Author            string
+
+	// EnabledPasses names the optimization passes to run, in order, before
+	// codegen. Leave nil/empty to run DefaultPasses. Pass names not found
+	// in the pass registry are a Validate error.
+	EnabledPasses []string
+
+	// Encoding selects the state-encoding scheme used by the encode pass:
+	// "binary" (default), "one-hot", or "gray".
+	Encoding string
+}
+
+// State is one node of the FSM: its Moore outputs (signal -> driven value)
+// and the transitions out of it, tried in order, with the last untaken one
+// acting as the default/unconditional transition.
+type State struct {
+	Outputs     map[string]string // output signal name -> driven value expression
+	Transitions []Transition
+
+	// Encoding is the state-encoding pass's assigned bit pattern for this
+	// state, e.g. "00", "01" for binary or "0001" for one-hot. Empty until
+	// the encode pass runs.
+	Encoding string
+}
+
+// Transition is a guarded edge to another state. Condition == "" means the
+// transition is unconditional (or the catch-all default among siblings).
+type Transition struct {
+	Condition string
+	Next      string
+}
+
+// Condition is a named, reusable guard expression referenced by
+// Transition.Condition by its key in StateMachine.Conditions, e.g.
+// Conditions["btn_pressed"] = Condition{Expr: "btn = '1'"}.
+type Condition struct {
+	Expr string
 }
 
 type Variable struct {
@@ -44,57 +94,148 @@ type FunctionalUnit struct {
 	Registers map[string]Variable
 }
 
-func Parse(filename string) (*StateMachine, error) {
-	mac := new(StateMachine)
-
+// Decode reads filename and unmarshals it into a StateMachine as either
+// JSON or a binary proto (sniffFormat decides), without validating or
+// applying defaults. Most callers want Parse; Decode exists for callers
+// (like the CLI) that need to inspect Validate's issues before deciding
+// whether to proceed.
+func Decode(filename string) (*StateMachine, error) {
 	fileBytes, err := ioutil.ReadFile(filename)
 	if err != nil {
 		return nil, err
 	}
 
-	err = json.Unmarshal(fileBytes, mac)
+	if sniffFormat(filename, fileBytes) == "proto" {
+		return parseProto(fileBytes)
+	}
 
-	if err != nil {
+	mac := new(StateMachine)
+	if err := json.Unmarshal(fileBytes, mac); err != nil {
 		return nil, err
 	}
+	return mac, nil
+}
+
+// Parse is ParseWithLogger with a no-op logger.
+func Parse(filename string) (*StateMachine, error) {
+	return ParseWithLogger(filename, log.NewNopLogger())
+}
 
-	err = mac.Validate()
+// ParseWithLogger decodes filename, validates it (failing on any Error-
+// severity ValidationIssue), fills in defaults, and runs the optimization
+// pipeline, logging each step to logger.
+func ParseWithLogger(filename string, logger log.Logger) (*StateMachine, error) {
+	mac, err := Decode(filename)
 	if err != nil {
 		return nil, err
 	}
+	mac.Logger = logger
+
+	if issues := mac.Validate(); HasErrors(issues) {
+		return nil, validationError(issues)
+	}
 
 	mac.FixUpWithDefaults()
 
+	if err := mac.RunPasses(); err != nil {
+		return nil, err
+	}
+
 	return mac, nil
 }
 
-func (m *StateMachine) Validate() error {
-	// Options
+// Severity classifies a ValidationIssue. Only SeverityError should stop a
+// caller from proceeding; SeverityWarn issues are informational.
+type Severity int
+
+const (
+	SeverityWarn Severity = iota
+	SeverityError
+)
+
+func (s Severity) String() string {
+	if s == SeverityError {
+		return "error"
+	}
+	return "warn"
+}
+
+// ValidationIssue is one problem Validate found, pinpointed by a
+// JSON-pointer style Path (e.g. "/Inputs/foo/BitWidth") rather than just a
+// flat message, so tooling can associate it with the offending field.
+type ValidationIssue struct {
+	Severity Severity
+	Path     string
+	Message  string
+}
+
+// HasErrors reports whether any issue in issues is SeverityError.
+func HasErrors(issues []ValidationIssue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func validationError(issues []ValidationIssue) error {
+	var msgs []string
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			msgs = append(msgs, issue.Path+": "+issue.Message)
+		}
+	}
+	return errors.New("asmd: validation failed: " + strings.Join(msgs, "; "))
+}
+
+// Validate checks m for problems, returning every issue found rather than
+// stopping at the first one, and logging each to m.Logger as it's found.
+func (m *StateMachine) Validate() []ValidationIssue {
+	logger := m.logger()
+	var issues []ValidationIssue
+
+	add := func(sev Severity, path, message string) {
+		issues = append(issues, ValidationIssue{sev, path, message})
+		logFn := level.Warn
+		if sev == SeverityError {
+			logFn = level.Error
+		}
+		logFn(logger).Log("pass", "validate", "path", path, "msg", message)
+	}
+
 	if m.Options.ModuleName == "" {
-		return errors.New("No module name specified (m.Options.Modulename).")
+		add(SeverityError, "/Options/ModuleName", "no module name specified")
 	}
 
 	clockType := strings.ToLower(m.Options.ClockType)
 	if !(clockType == "posedge" || clockType == "negedge") {
-		return errors.New("m.Options.ClockType must be 'negedge' or 'posedge', not " + m.Options.ClockType)
+		add(SeverityError, "/Options/ClockType", "must be 'posedge' or 'negedge', not "+m.Options.ClockType)
 	}
 
 	if m.Options.FirstState == "" {
-		return errors.New("m.Options.FirstState not specified.")
+		add(SeverityError, "/Options/FirstState", "not specified")
+	} else if _, ok := m.States[m.Options.FirstState]; !ok {
+		add(SeverityError, "/Options/FirstState", "not found in /States: "+m.Options.FirstState)
+	}
+
+	for _, name := range m.Options.EnabledPasses {
+		if _, ok := passRegistry[name]; !ok {
+			add(SeverityError, "/Options/EnabledPasses", "unknown pass: "+name)
+		}
 	}
-	// TODO
-	//if !(m.Options.FirstState in m.States) {
-	//	return errors.New("m.Options.FirstState, "+m.Options.FirstState+", is not in m.States.")
-	//}
 
 	// TODO everything else
 	if len(m.Inputs) == 0 {
-		return errors.New("No inputs specified.")
+		add(SeverityError, "/Inputs", "no inputs specified")
 	}
-	return nil
+
+	return issues
 }
 
 func (m *StateMachine) FixUpWithDefaults() {
+	logger := m.logger()
+
 	// m.Options
 
 	// fix up module name to be a valid VHDL module name
@@ -105,10 +246,17 @@ func (m *StateMachine) FixUpWithDefaults() {
 	if m.Options.AddAsyncReset == nil {
 		m.Options.AddAsyncReset = new(bool)
 		*m.Options.AddAsyncReset = true
+		level.Debug(logger).Log("pass", "fixup", "field", "AddAsyncReset", "default", true)
 	}
 
 	if m.Options.Indent == "" {
 		m.Options.Indent = "    "
+		level.Debug(logger).Log("pass", "fixup", "field", "Indent", "default", m.Options.Indent)
+	}
+
+	if m.Options.Encoding == "" {
+		m.Options.Encoding = "binary"
+		level.Debug(logger).Log("pass", "fixup", "field", "Encoding", "default", m.Options.Encoding)
 	}
 
 	// m.Inputs
@@ -119,20 +267,15 @@ func (m *StateMachine) FixUpWithDefaults() {
 	}
 }
 
-// TODO make this durned thing not throw exceptions, or catch them locally
-func write(f *os.File, ss ...string) {
-	for _, s := range ss {
-		n, err := f.WriteString(s)
-		if err != nil {
-			panic(err)
-		}
-		if n != len(s) {
-			panic(errors.New("Unable to write full string to file"))
-		}
-	}
+// TrimmedModuleName returns the valid-identifier form of ModuleName computed
+// by FixUpWithDefaults, for backends that can't reach the unexported field.
+func (o Options) TrimmedModuleName() string {
+	return o.trimmedModuleName
 }
 
-func (m *StateMachine) indent(n uint) string {
+// Indent returns n repetitions of Options.Indent, for backends to build
+// nested HDL bodies without each hard-coding its own whitespace.
+func (m *StateMachine) Indent(n uint) string {
 	s := ""
 	var i uint
 	if m.Options.Indent == "" {
@@ -143,140 +286,3 @@ func (m *StateMachine) indent(n uint) string {
 	}
 	return s
 }
-
-func (m *StateMachine) VHDL(filename string) (err error) {
-	file, err := os.Create(filename)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	// Comments
-	write(file, "\n")
-	write(file, "--------------------------------------------------------------------------------\n")
-	write(file, "-- Module Name: ", m.Options.ModuleName, "\n")
-	write(file, "-- Author:      ", m.Options.Author, "\n")
-	write(file, "-- Date:        ", time.Now().Format("2 Jan 2006"), "\n")
-	write(file, "--\n")
-	write(file, "--------------------------------------------------------------------------------\n")
-	write(file, "\n")
-	write(file, "\n")
-
-	// library and use statements
-	// TODO infer the minimal set using given types
-	write(file, "library IEEE;\n")
-	write(file, "use IEEE.STD_LOGIC_1164.ALL;\n")
-	write(file, "use IEEE.NUMERIC_STD.ALL;\n")
-	write(file, "\n")
-
-	// entity start
-	trimmedModuleName := m.Options.trimmedModuleName
-	write(file, "entity ", m.Options.trimmedModuleName, " is\n")
-
-	// Entity - Generics
-	if len(m.Parameters) > 0 {
-		write(file, m.indent(1), "generic (\n")
-		isFirst := true
-		for name, properties := range m.Parameters {
-			write(file, m.indent(2))
-			if isFirst {
-				write(file, "  ")
-				isFirst = false
-			} else {
-				write(file, "; ")
-			}
-			write(file, name, ": ", properties.Type, " := ", properties.DefaultValue)
-			write(file, "\n")
-		}
-		write(file, m.indent(1), ");\n")
-	}
-
-	if len(m.Inputs) > 0 || len(m.Outputs) > 0 {
-		write(file, m.indent(1), "port (\n")
-		var isFirst bool
-
-		// Entity - Inputs
-		isFirst = true
-		for name, properties := range m.Inputs {
-			write(file, m.indent(2))
-			if isFirst {
-				write(file, "  ")
-				isFirst = false
-			} else {
-				write(file, "; ")
-			}
-			write(file, name, " : in std_logic")
-			if properties.BitWidth > 1 {
-				write(file, "_vector (", strconv.FormatUint(properties.BitWidth-1, 10), " downto 0)")
-			}
-			write(file, "\n")
-		}
-
-		// Entity - Outputs
-		// We're merely continuing the same list so don't reset isFirst.
-		// TODO make this DRY with Inputs section
-		for name, properties := range m.Outputs {
-			write(file, m.indent(2))
-			if isFirst {
-				write(file, "  ")
-				isFirst = false
-			} else {
-				write(file, "; ")
-			}
-			write(file, name, " : out std_logic")
-			if properties.BitWidth > 1 {
-				write(file, "_vector (", strconv.FormatUint(properties.BitWidth-1, 10), " downto 0)")
-			}
-			write(file, "\n")
-		}
-
-		write(file, m.indent(1), ");\n")
-	}
-
-	// Entity end
-	write(file, "end ", trimmedModuleName, ";\n")
-	write(file, "\n")
-
-	// architecture start
-	write(file, "architecture Behavioral of ", trimmedModuleName, " is\n")
-
-	// Constants (?)
-	// Internal Signals
-	// Internal signals for functional units
-
-	write(file, m.indent(1), "-- FSM declarations\n")
-	// State Machine "Next"s
-	write(file, m.indent(1), "type state is (")
-	//for stateName, _ := range m.States {} // TODO
-	write(file, ");\n")
-	// State machine states
-	//if _, ok := m.Options.FirstState in  // verify FirstState is valid
-	write(file, m.indent(1), "signal state_reg, state_next : state := ", m.Options.FirstState, ";\n")
-
-	// architecture "begin"
-	write(file, "begin\n")
-
-	// Register process
-	write(file, m.indent(1), "-- FSM state register\n")
-	write(file, m.indent(1), "process(clk, rst)\n")
-	write(file, m.indent(1), "begin\n")
-	write(file, m.indent(2), "if (rst='1') then\n")
-	write(file, m.indent(3), "state_reg <= ", m.Options.FirstState, ";\n")
-	if m.Options.ClockType == "posedge" {
-		write(file, m.indent(2), "elsif (clk'event and clk='1') then\n")
-	} else if m.Options.ClockType == "negedge" {
-		write(file, m.indent(2), "elsif (clk'event and clk='0') then\n")
-	} else {
-		return errors.New("Unrecognized clock type: " + m.Options.ClockType)
-	}
-	write(file, m.indent(3), "state_reg <= state_next;\n")
-	write(file, m.indent(2), "end if;\n")
-	write(file, m.indent(1), "end process;\n")
-	// Next State process
-	// Mealy(?) Output process
-	// architecture end
-	write(file, "end Behavioral;\n")
-	write(file, "\n")
-
-	return nil
-}
@@ -0,0 +1,81 @@
+package asmd
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	"github.com/go-kit/log/level"
+)
+
+// Backend turns a validated, pass-processed StateMachine into HDL source
+// for one target language. Emit writes the whole module; the smaller hooks
+// exist so implementations only need to vary syntax, typing, and
+// process/always-block shape rather than re-deriving module structure.
+//
+// Implementations live under asmd/backend/ (vhdl, verilog, sv) and register
+// themselves from an init() func via RegisterBackend, the same way
+// database/sql drivers and image codecs do: import the backend package for
+// its side effect to make the target available to Generate.
+type Backend interface {
+	// Emit writes the whole module for m to w.
+	Emit(w io.Writer, m *StateMachine) error
+
+	// PortDecl renders one port declaration; direction is "in" or "out".
+	PortDecl(name string, v Variable, direction string) string
+
+	// SignalDecl renders one internal signal/reg/wire declaration.
+	SignalDecl(name string, v Variable) string
+
+	// ProcessHeader renders the sensitivity-list/always header that begins
+	// the clocked state register process.
+	ProcessHeader(clockType string, hasReset bool) string
+
+	// CaseStmt renders the opening line of a case/casez over signal.
+	CaseStmt(signal string) string
+}
+
+var backends = map[string]Backend{}
+
+// RegisterBackend makes a Backend available under name for
+// StateMachine.Generate. Called from backend packages' init() funcs.
+func RegisterBackend(name string, b Backend) {
+	backends[name] = b
+}
+
+// Generate renders m with the backend registered under target (e.g.
+// "vhdl", "verilog", "sv") and writes the result to filename. The caller
+// must have imported the matching asmd/backend/<target> package (for its
+// init side effect) beforehand.
+func (m *StateMachine) Generate(target string, filename string) error {
+	logger := m.logger()
+
+	b, ok := backends[target]
+	if !ok {
+		err := errors.New("asmd: no backend registered for target " + target + " (forgot to import asmd/backend/" + target + "?)")
+		level.Error(logger).Log("target", target, "err", err)
+		return err
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		level.Error(logger).Log("target", target, "file", filename, "err", err)
+		return err
+	}
+	defer file.Close()
+
+	if err := b.Emit(file, m); err != nil {
+		level.Error(logger).Log("target", target, "file", filename, "err", err)
+		return err
+	}
+
+	level.Info(logger).Log("target", target, "file", filename, "msg", "generated")
+	return nil
+}
+
+// VHDL renders m as VHDL to filename. It is a convenience wrapper around
+// Generate("vhdl", filename) kept for existing callers, and requires
+// asmd/backend/vhdl to have been imported.
+func (m *StateMachine) VHDL(filename string) error {
+	return m.Generate("vhdl", filename)
+}
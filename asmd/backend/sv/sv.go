@@ -0,0 +1,165 @@
+// Package sv is the asmd.Backend that emits SystemVerilog, using `logic`
+// typing, a `typedef enum` for the state type (explicitly sized and valued
+// from asmd.State.Encoding so the encode pass still controls the bit
+// pattern), and always_ff/always_comb instead of plain always blocks.
+package sv
+
+import (
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/sashalemon/asmd-gen/asmd"
+)
+
+func init() {
+	asmd.RegisterBackend("sv", backend{})
+}
+
+type backend struct{}
+
+func write(w io.Writer, errp *error, ss ...string) {
+	if *errp != nil {
+		return
+	}
+	for _, s := range ss {
+		if _, err := io.WriteString(w, s); err != nil {
+			*errp = err
+			return
+		}
+	}
+}
+
+func (backend) Emit(w io.Writer, m *asmd.StateMachine) error {
+	var err error
+
+	write(w, &err, "//", "\n")
+	write(w, &err, "// Module Name: ", m.Options.ModuleName, "\n")
+	write(w, &err, "// Author:      ", m.Options.Author, "\n")
+	write(w, &err, "// Date:        ", time.Now().Format("2 Jan 2006"), "\n")
+	write(w, &err, "//\n")
+	write(w, &err, "\n")
+
+	moduleName := m.Options.TrimmedModuleName()
+	write(w, &err, "module ", moduleName, " (\n")
+
+	ports := make([]string, 0, len(m.Inputs)+len(m.Outputs))
+	for name, v := range m.Inputs {
+		ports = append(ports, backend{}.PortDecl(name, v, "in"))
+	}
+	for name, v := range m.Outputs {
+		ports = append(ports, backend{}.PortDecl(name, v, "out"))
+	}
+	for i, p := range ports {
+		write(w, &err, m.Indent(1), p)
+		if i < len(ports)-1 {
+			write(w, &err, ",")
+		}
+		write(w, &err, "\n")
+	}
+	write(w, &err, ");\n\n")
+
+	stateNames := m.StateNames()
+	width := m.EncodingWidth()
+	write(w, &err, m.Indent(1), "typedef enum logic [", strconv.Itoa(width-1), ":0] {\n")
+	for i, name := range stateNames {
+		write(w, &err, m.Indent(2), name, " = ", strconv.Itoa(width), "'b", m.States[name].Encoding)
+		if i < len(stateNames)-1 {
+			write(w, &err, ",")
+		}
+		write(w, &err, "\n")
+	}
+	write(w, &err, m.Indent(1), "} state_t;\n\n")
+	write(w, &err, m.Indent(1), "state_t state_reg, state_next;\n\n")
+
+	write(w, &err, m.Indent(1), "// FSM state register\n")
+	write(w, &err, m.Indent(1), backend{}.ProcessHeader(m.Options.ClockType, true))
+	write(w, &err, m.Indent(2), "if (rst)\n")
+	write(w, &err, m.Indent(3), "state_reg <= ", m.Options.FirstState, ";\n")
+	write(w, &err, m.Indent(2), "else\n")
+	write(w, &err, m.Indent(3), "state_reg <= state_next;\n")
+	write(w, &err, "    end\n\n")
+
+	write(w, &err, m.Indent(1), "// FSM next-state logic\n")
+	write(w, &err, m.Indent(1), "always_comb begin\n")
+	write(w, &err, m.Indent(2), "state_next = state_reg;\n")
+	write(w, &err, m.Indent(2), backend{}.CaseStmt("state_reg"), "\n")
+	for _, name := range stateNames {
+		state := m.States[name]
+		write(w, &err, m.Indent(3), name, ": begin\n")
+		writeTransitionChain(w, &err, m, state.Transitions)
+		write(w, &err, m.Indent(3), "end\n")
+	}
+	write(w, &err, m.Indent(2), "endcase\n")
+	write(w, &err, m.Indent(1), "end\n\n")
+
+	write(w, &err, "endmodule\n")
+
+	return err
+}
+
+// writeTransitionChain emits transitions as an if/else if/.../else priority
+// chain rather than a sequence of independent ifs, so the documented
+// contract (asmd.State.Transitions are tried in order, the last untaken one
+// acting as the default) actually holds: a later transition's assignment
+// can no longer unconditionally clobber an earlier guarded one. A trailing
+// unconditional transition becomes the "else"; an unconditional transition
+// anywhere else ends the chain there; transitions behind it are unreachable
+// per that same contract and are dropped rather than emitted as dead code.
+func writeTransitionChain(w io.Writer, errp *error, m *asmd.StateMachine, transitions []asmd.Transition) {
+	opened := false
+	for i, t := range transitions {
+		if t.Condition == "" {
+			prefix := "state_next = "
+			if i > 0 {
+				prefix = "else state_next = "
+			}
+			write(w, errp, m.Indent(4), prefix, t.Next, ";\n")
+			return
+		}
+		cond := t.Condition
+		if c, ok := m.Conditions[t.Condition]; ok {
+			cond = c.Expr
+		}
+		kw := "if"
+		if opened {
+			kw = "else if"
+		}
+		write(w, errp, m.Indent(4), kw, " (", cond, ") state_next = ", t.Next, ";\n")
+		opened = true
+	}
+}
+
+func (backend) PortDecl(name string, v asmd.Variable, direction string) string {
+	s := "input logic "
+	if direction == "out" {
+		s = "output logic "
+	}
+	if v.BitWidth > 1 {
+		s += "[" + strconv.FormatUint(v.BitWidth-1, 10) + ":0] "
+	}
+	return s + name
+}
+
+func (backend) SignalDecl(name string, v asmd.Variable) string {
+	s := "logic "
+	if v.BitWidth > 1 {
+		s += "[" + strconv.FormatUint(v.BitWidth-1, 10) + ":0] "
+	}
+	return s + name + ";"
+}
+
+func (backend) ProcessHeader(clockType string, hasReset bool) string {
+	edge := "posedge"
+	if clockType == "negedge" {
+		edge = "negedge"
+	}
+	if hasReset {
+		return "always_ff @(" + edge + " clk or posedge rst) begin\n"
+	}
+	return "always_ff @(" + edge + " clk) begin\n"
+}
+
+func (backend) CaseStmt(signal string) string {
+	return "case (" + signal + ")"
+}
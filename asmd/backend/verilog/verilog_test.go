@@ -0,0 +1,49 @@
+package verilog
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/sashalemon/asmd-gen/asmd"
+)
+
+// guardedDefaultMachine builds the documented shape from asmd.State's doc
+// comment: a guarded transition followed by a trailing unconditional one
+// acting as the default.
+func guardedDefaultMachine() *asmd.StateMachine {
+	m := &asmd.StateMachine{
+		Options: asmd.Options{ModuleName: "t", FirstState: "idle", ClockType: "posedge"},
+		Inputs:  map[string]asmd.Variable{"go": {}},
+		States: map[string]asmd.State{
+			"idle": {Transitions: []asmd.Transition{
+				{Condition: "go_hi", Next: "run"},
+				{Next: "idle"},
+			}},
+			"run": {},
+		},
+		Conditions: map[string]asmd.Condition{"go_hi": {Expr: "go"}},
+	}
+	m.FixUpWithDefaults()
+	return m
+}
+
+// TestEmitGuardedDefaultIsPriorityChain guards against the bug where the
+// default transition was emitted as a bare, unguarded assignment after the
+// guarded one, silently clobbering it every time regardless of the guard.
+func TestEmitGuardedDefaultIsPriorityChain(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (backend{}).Emit(&buf, guardedDefaultMachine()); err != nil {
+		t.Fatalf("Emit: %v", err)
+	}
+	out := buf.String()
+
+	want := "if (go) state_next = run;\n" +
+		"                else state_next = idle;\n"
+	if !strings.Contains(out, want) {
+		t.Errorf("Emit output missing priority chain %q; got:\n%s", want, out)
+	}
+	if strings.Contains(out, "state_next = run;\n                state_next = idle;\n") {
+		t.Errorf("default assignment still appears unguarded after the guarded one; got:\n%s", out)
+	}
+}
@@ -0,0 +1,303 @@
+// Package vhdl is the asmd.Backend that emits VHDL-93: a state register
+// process, a next-state process driven by State.Transitions/Condition.Expr,
+// and a Moore output process driven by State.Outputs, mirroring the
+// verilog and sv backends' case-statement shape.
+package vhdl
+
+import (
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/sashalemon/asmd-gen/asmd"
+)
+
+func init() {
+	asmd.RegisterBackend("vhdl", backend{})
+}
+
+type backend struct{}
+
+// write accumulates the first error across a sequence of writes so callers
+// don't have to check every single one.
+func write(w io.Writer, errp *error, ss ...string) {
+	if *errp != nil {
+		return
+	}
+	for _, s := range ss {
+		if _, err := io.WriteString(w, s); err != nil {
+			*errp = err
+			return
+		}
+	}
+}
+
+func (backend) Emit(w io.Writer, m *asmd.StateMachine) error {
+	var err error
+
+	// Comments
+	write(w, &err, "\n")
+	write(w, &err, "--------------------------------------------------------------------------------\n")
+	write(w, &err, "-- Module Name: ", m.Options.ModuleName, "\n")
+	write(w, &err, "-- Author:      ", m.Options.Author, "\n")
+	write(w, &err, "-- Date:        ", time.Now().Format("2 Jan 2006"), "\n")
+	write(w, &err, "--\n")
+	write(w, &err, "--------------------------------------------------------------------------------\n")
+	write(w, &err, "\n")
+	write(w, &err, "\n")
+
+	// library and use statements
+	// TODO infer the minimal set using given types
+	write(w, &err, "library IEEE;\n")
+	write(w, &err, "use IEEE.STD_LOGIC_1164.ALL;\n")
+	write(w, &err, "use IEEE.NUMERIC_STD.ALL;\n")
+	write(w, &err, "\n")
+
+	// entity start
+	trimmedModuleName := m.Options.TrimmedModuleName()
+	write(w, &err, "entity ", trimmedModuleName, " is\n")
+
+	// Entity - Generics
+	if len(m.Parameters) > 0 {
+		write(w, &err, m.Indent(1), "generic (\n")
+		isFirst := true
+		for name, properties := range m.Parameters {
+			write(w, &err, m.Indent(2))
+			if isFirst {
+				write(w, &err, "  ")
+				isFirst = false
+			} else {
+				write(w, &err, "; ")
+			}
+			write(w, &err, name, ": ", properties.Type, " := ", properties.DefaultValue)
+			write(w, &err, "\n")
+		}
+		write(w, &err, m.Indent(1), ");\n")
+	}
+
+	if len(m.Inputs) > 0 || len(m.Outputs) > 0 {
+		write(w, &err, m.Indent(1), "port (\n")
+		var isFirst bool
+
+		// Entity - Inputs
+		isFirst = true
+		for name, properties := range m.Inputs {
+			write(w, &err, m.Indent(2))
+			if isFirst {
+				write(w, &err, "  ")
+				isFirst = false
+			} else {
+				write(w, &err, "; ")
+			}
+			write(w, &err, backend{}.PortDecl(name, properties, "in"))
+			write(w, &err, "\n")
+		}
+
+		// Entity - Outputs
+		// We're merely continuing the same list so don't reset isFirst.
+		for name, properties := range m.Outputs {
+			write(w, &err, m.Indent(2))
+			if isFirst {
+				write(w, &err, "  ")
+				isFirst = false
+			} else {
+				write(w, &err, "; ")
+			}
+			write(w, &err, backend{}.PortDecl(name, properties, "out"))
+			write(w, &err, "\n")
+		}
+
+		write(w, &err, m.Indent(1), ");\n")
+	}
+
+	// Entity end
+	write(w, &err, "end ", trimmedModuleName, ";\n")
+	write(w, &err, "\n")
+
+	// architecture start
+	write(w, &err, "architecture Behavioral of ", trimmedModuleName, " is\n")
+
+	// Constants (?)
+	// Internal Signals
+	// Internal signals for functional units
+
+	write(w, &err, m.Indent(1), "-- FSM declarations\n")
+	// State Machine "Next"s
+	write(w, &err, m.Indent(1), "type state is (")
+	stateNames := m.StateNames()
+	for i, name := range stateNames {
+		if i > 0 {
+			write(w, &err, ", ")
+		}
+		write(w, &err, name)
+	}
+	write(w, &err, ");\n")
+	// State machine states
+	write(w, &err, m.Indent(1), "signal state_reg, state_next : state := ", m.Options.FirstState, ";\n")
+
+	// architecture "begin"
+	write(w, &err, "begin\n")
+
+	// Register process
+	write(w, &err, m.Indent(1), "-- FSM state register\n")
+	write(w, &err, m.Indent(1), backend{}.ProcessHeader(m.Options.ClockType, true))
+	write(w, &err, m.Indent(1), "begin\n")
+	write(w, &err, m.Indent(2), "if (rst='1') then\n")
+	write(w, &err, m.Indent(3), "state_reg <= ", m.Options.FirstState, ";\n")
+	if err == nil {
+		switch m.Options.ClockType {
+		case "posedge":
+			write(w, &err, m.Indent(2), "elsif (clk'event and clk='1') then\n")
+		case "negedge":
+			write(w, &err, m.Indent(2), "elsif (clk'event and clk='0') then\n")
+		default:
+			return errors.New("Unrecognized clock type: " + m.Options.ClockType)
+		}
+	}
+	write(w, &err, m.Indent(3), "state_reg <= state_next;\n")
+	write(w, &err, m.Indent(2), "end if;\n")
+	write(w, &err, m.Indent(1), "end process;\n")
+	write(w, &err, "\n")
+
+	// Next-state process. Sensitivity list covers state_reg plus every
+	// signal a Condition.Expr could plausibly reference (inputs, registers,
+	// parameters); VHDL-93 has no process(all), so this has to be listed
+	// explicitly rather than inferred the way verilog's always @(*) is.
+	write(w, &err, m.Indent(1), "-- FSM next-state logic\n")
+	write(w, &err, m.Indent(1), "process(state_reg")
+	for _, name := range sortedVariableNames(m.Inputs) {
+		write(w, &err, ", ", name)
+	}
+	for _, name := range sortedVariableNames(m.Registers) {
+		write(w, &err, ", ", name)
+	}
+	for _, name := range sortedVariableNames(m.Parameters) {
+		write(w, &err, ", ", name)
+	}
+	write(w, &err, ")\n")
+	write(w, &err, m.Indent(1), "begin\n")
+	write(w, &err, m.Indent(2), "state_next <= state_reg;\n")
+	write(w, &err, m.Indent(2), backend{}.CaseStmt("state_reg"), "\n")
+	for _, name := range stateNames {
+		state := m.States[name]
+		write(w, &err, m.Indent(3), "when ", name, " =>\n")
+		if len(state.Transitions) == 0 {
+			write(w, &err, m.Indent(4), "null;\n")
+			continue
+		}
+		writeTransitionChain(w, &err, m, state.Transitions)
+	}
+	write(w, &err, m.Indent(2), "end case;\n")
+	write(w, &err, m.Indent(1), "end process;\n")
+	write(w, &err, "\n")
+
+	// Output process: Moore outputs, driven by the current state alone.
+	write(w, &err, m.Indent(1), "-- FSM outputs\n")
+	write(w, &err, m.Indent(1), "process(state_reg)\n")
+	write(w, &err, m.Indent(1), "begin\n")
+	write(w, &err, m.Indent(2), backend{}.CaseStmt("state_reg"), "\n")
+	for _, name := range stateNames {
+		state := m.States[name]
+		write(w, &err, m.Indent(3), "when ", name, " =>\n")
+		outputNames := sortedOutputNames(state.Outputs)
+		if len(outputNames) == 0 {
+			write(w, &err, m.Indent(4), "null;\n")
+		}
+		for _, sig := range outputNames {
+			write(w, &err, m.Indent(4), sig, " <= ", state.Outputs[sig], ";\n")
+		}
+	}
+	write(w, &err, m.Indent(2), "end case;\n")
+	write(w, &err, m.Indent(1), "end process;\n")
+
+	// architecture end
+	write(w, &err, "end Behavioral;\n")
+	write(w, &err, "\n")
+
+	return err
+}
+
+// writeTransitionChain emits transitions as an if/elsif/.../else priority
+// chain rather than a sequence of independent ifs, so the documented
+// contract (asmd.State.Transitions are tried in order, the last untaken one
+// acting as the default) actually holds: a later transition's assignment
+// can no longer unconditionally clobber an earlier guarded one. A trailing
+// unconditional transition becomes the "else"; an unconditional transition
+// anywhere else ends the chain there; transitions behind it are unreachable
+// per that same contract and are dropped rather than emitted as dead code.
+func writeTransitionChain(w io.Writer, errp *error, m *asmd.StateMachine, transitions []asmd.Transition) {
+	opened := false
+	for i, t := range transitions {
+		if t.Condition == "" {
+			if i == 0 {
+				write(w, errp, m.Indent(4), "state_next <= ", t.Next, ";\n")
+			} else {
+				write(w, errp, m.Indent(4), "else\n")
+				write(w, errp, m.Indent(5), "state_next <= ", t.Next, ";\n")
+				write(w, errp, m.Indent(4), "end if;\n")
+			}
+			return
+		}
+		cond := t.Condition
+		if c, ok := m.Conditions[t.Condition]; ok {
+			cond = c.Expr
+		}
+		kw := "if"
+		if opened {
+			kw = "elsif"
+		}
+		write(w, errp, m.Indent(4), kw, " (", cond, ") then\n")
+		write(w, errp, m.Indent(5), "state_next <= ", t.Next, ";\n")
+		opened = true
+	}
+	if opened {
+		write(w, errp, m.Indent(4), "end if;\n")
+	}
+}
+
+func sortedVariableNames(vars map[string]asmd.Variable) []string {
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedOutputNames(outputs map[string]string) []string {
+	names := make([]string, 0, len(outputs))
+	for name := range outputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (backend) PortDecl(name string, v asmd.Variable, direction string) string {
+	s := name + " : " + direction + " std_logic"
+	if v.BitWidth > 1 {
+		s += "_vector (" + strconv.FormatUint(v.BitWidth-1, 10) + " downto 0)"
+	}
+	return s
+}
+
+func (backend) SignalDecl(name string, v asmd.Variable) string {
+	s := "signal " + name + " : std_logic"
+	if v.BitWidth > 1 {
+		s += "_vector (" + strconv.FormatUint(v.BitWidth-1, 10) + " downto 0)"
+	}
+	return s + ";"
+}
+
+func (backend) ProcessHeader(clockType string, hasReset bool) string {
+	if hasReset {
+		return "process(clk, rst)\n"
+	}
+	return "process(clk)\n"
+}
+
+func (backend) CaseStmt(signal string) string {
+	return "case " + signal + " is"
+}
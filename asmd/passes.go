@@ -0,0 +1,351 @@
+package asmd
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/go-kit/log/level"
+)
+
+// Pass is one step of the compiler pipeline run between Parse and codegen.
+// A pass mutates m in place and returns an error if it cannot proceed; a nil
+// error means m was left in a valid state for the next pass.
+type Pass func(m *StateMachine) error
+
+// DefaultPasses is the pipeline order used when Options.EnabledPasses is
+// empty: drop unreachable states, merge equivalent ones, then assign each
+// surviving state an encoding. Codegen (VHDL, and friends) reads the result.
+var DefaultPasses = []string{"reachability", "minimize", "encode"}
+
+var passRegistry = map[string]Pass{
+	"reachability": ReachabilityPass,
+	"minimize":     MinimizePass,
+	"encode":       EncodePass,
+}
+
+// RunPasses runs Options.EnabledPasses (or DefaultPasses, if unset) in
+// order, stopping at the first error.
+func (m *StateMachine) RunPasses() error {
+	names := m.Options.EnabledPasses
+	if len(names) == 0 {
+		names = DefaultPasses
+	}
+	logger := m.logger()
+	for _, name := range names {
+		if err := m.RunPass(name); err != nil {
+			level.Error(logger).Log("pass", name, "err", err)
+			return err
+		}
+		level.Info(logger).Log("pass", name, "states", len(m.States))
+	}
+	return nil
+}
+
+// RunPass runs a single named pass, looked up in the pass registry. Used by
+// callers (e.g. asmd/server's GenerateStream) that want to report progress
+// between passes rather than running the whole pipeline at once.
+func (m *StateMachine) RunPass(name string) error {
+	pass, ok := passRegistry[name]
+	if !ok {
+		return errors.New("asmd: unknown pass: " + name)
+	}
+	return pass(m)
+}
+
+// ReachabilityPass drops every state and condition unreachable from
+// Options.FirstState: states not found by BFS over m.States, and any
+// m.Conditions entry no surviving transition still refers to.
+func ReachabilityPass(m *StateMachine) error {
+	if _, ok := m.States[m.Options.FirstState]; !ok {
+		return errors.New("asmd: reachability pass: FirstState " + m.Options.FirstState + " not in States")
+	}
+
+	reached := map[string]bool{m.Options.FirstState: true}
+	queue := []string{m.Options.FirstState}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		for _, t := range m.States[name].Transitions {
+			if !reached[t.Next] {
+				reached[t.Next] = true
+				queue = append(queue, t.Next)
+			}
+		}
+	}
+
+	usedConditions := map[string]bool{}
+	for name := range reached {
+		for _, t := range m.States[name].Transitions {
+			if t.Condition != "" {
+				usedConditions[t.Condition] = true
+			}
+		}
+	}
+
+	for name := range m.States {
+		if !reached[name] {
+			delete(m.States, name)
+		}
+	}
+	for name := range m.Conditions {
+		if !usedConditions[name] {
+			delete(m.Conditions, name)
+		}
+	}
+	return nil
+}
+
+// MinimizePass merges equivalent states using partition refinement. Two states start in the same block only if they drive
+// identical Moore outputs; the worklist algorithm then splits blocks apart
+// whenever a condition routes their members into different blocks, until
+// no more splits occur. Surviving states are rewritten to point at their
+// block's canonical (lexicographically smallest) representative, and every
+// other member of a merged block is deleted.
+func MinimizePass(m *StateMachine) error {
+	if len(m.States) == 0 {
+		return nil
+	}
+
+	// blockOf[state] -> block id. Start with one block per distinct
+	// Moore-output vector, since only states with identical outputs can
+	// ever be equivalent.
+	blockOf := make(map[string]int, len(m.States))
+	blockKey := make(map[string]int)
+	names := m.StateNames()
+	for _, name := range names {
+		key := outputKey(m.States[name].Outputs)
+		id, ok := blockKey[key]
+		if !ok {
+			id = len(blockKey)
+			blockKey[key] = id
+		}
+		blockOf[name] = id
+	}
+
+	blocks := make(map[int][]string)
+	for name, id := range blockOf {
+		blocks[id] = append(blocks[id], name)
+	}
+	nextBlockID := len(blockKey)
+
+	// conditions used anywhere, so we know which symbols to split on.
+	var conditions []string
+	seenCond := map[string]bool{}
+	for _, name := range names {
+		for _, t := range m.States[name].Transitions {
+			if !seenCond[t.Condition] {
+				seenCond[t.Condition] = true
+				conditions = append(conditions, t.Condition)
+			}
+		}
+	}
+	sort.Strings(conditions)
+
+	// Worklist of (block, condition) pairs still to check, seeded with
+	// every pair. A split can change which block other blocks' members
+	// lead to, so after any split every block is queued again against
+	// every condition. Hopcroft's smaller-half rule is not used, so the
+	// worst case is above O(n log n).
+	type work struct {
+		block int
+		cond  string
+	}
+	var worklist []work
+	for id := range blocks {
+		for _, c := range conditions {
+			worklist = append(worklist, work{id, c})
+		}
+	}
+
+	destBlock := func(name, cond string) int {
+		for _, t := range m.States[name].Transitions {
+			if t.Condition == cond {
+				return blockOf[t.Next]
+			}
+		}
+		return -1 // no transition on this condition: its own bucket
+	}
+
+	for len(worklist) > 0 {
+		w := worklist[0]
+		worklist = worklist[1:]
+
+		members, ok := blocks[w.block]
+		if !ok || len(members) < 2 {
+			continue
+		}
+
+		groups := make(map[int][]string)
+		for _, name := range members {
+			d := destBlock(name, w.cond)
+			groups[d] = append(groups[d], name)
+		}
+		if len(groups) < 2 {
+			continue // no split needed
+		}
+
+		// Keep the largest group in the original block id; every other
+		// group becomes a fresh block. Then re-queue every block against
+		// every condition.
+		var largest int
+		largestSize := -1
+		for d, members := range groups {
+			if len(members) > largestSize {
+				largest, largestSize = d, len(members)
+			}
+		}
+
+		blocks[w.block] = groups[largest]
+		for d, members := range groups {
+			if d == largest {
+				continue
+			}
+			id := nextBlockID
+			nextBlockID++
+			blocks[id] = members
+			for _, name := range members {
+				blockOf[name] = id
+			}
+		}
+		for id := range blocks {
+			for _, c := range conditions {
+				worklist = append(worklist, work{id, c})
+			}
+		}
+	}
+
+	// Pick the canonical (lexicographically smallest) member of each
+	// block, rewrite every transition to point at it, then drop the rest.
+	canonical := make(map[int]string, len(blocks))
+	for id, members := range blocks {
+		sort.Strings(members)
+		canonical[id] = members[0]
+	}
+
+	for _, name := range names {
+		state, ok := m.States[name]
+		if !ok {
+			continue
+		}
+		for i, t := range state.Transitions {
+			state.Transitions[i].Next = canonical[blockOf[t.Next]]
+		}
+		m.States[name] = state
+	}
+
+	if m.Options.FirstState != "" {
+		if rep, ok := canonical[blockOf[m.Options.FirstState]]; ok {
+			m.Options.FirstState = rep
+		}
+	}
+
+	for _, name := range names {
+		if canonical[blockOf[name]] != name {
+			delete(m.States, name)
+		}
+	}
+
+	return nil
+}
+
+func outputKey(outputs map[string]string) string {
+	names := make([]string, 0, len(outputs))
+	for k := range outputs {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	key := ""
+	for _, n := range names {
+		key += n + "=" + outputs[n] + ";"
+	}
+	return key
+}
+
+// StateNames returns m.States's keys in lexicographic order, the
+// deterministic iteration order every pass and backend relies on.
+func (m *StateMachine) StateNames() []string {
+	names := make([]string, 0, len(m.States))
+	for name := range m.States {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// EncodePass assigns each surviving state a bit pattern in m.States[x].Encoding
+// according to Options.Encoding ("binary", "one-hot", or "gray"), in
+// lexicographic state-name order so output is deterministic across runs.
+func EncodePass(m *StateMachine) error {
+	names := m.StateNames()
+	if len(names) == 0 {
+		return nil
+	}
+
+	mode := m.Options.Encoding
+	if mode == "" {
+		mode = "binary"
+	}
+
+	width := binaryWidth(len(names))
+
+	for i, name := range names {
+		var bits string
+		switch mode {
+		case "one-hot":
+			b := make([]byte, len(names))
+			for j := range b {
+				b[j] = '0'
+			}
+			b[i] = '1'
+			bits = string(b)
+		case "gray":
+			bits = toBinaryString(uint64(i^(i>>1)), width)
+		case "binary", "":
+			bits = toBinaryString(uint64(i), width)
+		default:
+			return errors.New("asmd: encode pass: unknown Options.Encoding: " + mode)
+		}
+		state := m.States[name]
+		state.Encoding = bits
+		m.States[name] = state
+	}
+	return nil
+}
+
+// binaryWidth returns ceil(log2(n)), but at least 1, the number of bits
+// needed to give n states distinct binary or gray codes.
+func binaryWidth(n int) int {
+	width := 1
+	for (1 << uint(width)) < n {
+		width++
+	}
+	return width
+}
+
+// EncodingWidth returns the bit width of m.States[x].Encoding as assigned by
+// EncodePass (one bit per state for one-hot, ceil(log2(n)) for binary/gray),
+// so backends can size state_reg/the state enum consistently with the
+// per-state literals. Falls back to the binary width if the encode pass
+// hasn't run yet.
+func (m *StateMachine) EncodingWidth() int {
+	names := m.StateNames()
+	if len(names) > 0 {
+		if enc := m.States[names[0]].Encoding; enc != "" {
+			return len(enc)
+		}
+	}
+	return binaryWidth(len(names))
+}
+
+func toBinaryString(v uint64, width int) string {
+	b := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		if v&1 == 1 {
+			b[i] = '1'
+		} else {
+			b[i] = '0'
+		}
+		v >>= 1
+	}
+	return string(b)
+}
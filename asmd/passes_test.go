@@ -0,0 +1,223 @@
+package asmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReachabilityPass(t *testing.T) {
+	m := &StateMachine{
+		Options: Options{FirstState: "start"},
+		States: map[string]State{
+			"start": {Outputs: map[string]string{"y": "0"}, Transitions: []Transition{{Condition: "sel", Next: "a"}}},
+			"a":     {Outputs: map[string]string{"y": "1"}, Transitions: []Transition{{Next: "start"}}},
+			"dead":  {Outputs: map[string]string{"y": "9"}, Transitions: []Transition{{Condition: "dead_sel", Next: "start"}}},
+		},
+		Conditions: map[string]Condition{
+			"sel":      {Expr: "go = '1'"},
+			"dead_sel": {Expr: "btn = '1'"},
+		},
+	}
+
+	if err := ReachabilityPass(m); err != nil {
+		t.Fatalf("ReachabilityPass: %v", err)
+	}
+
+	if _, ok := m.States["dead"]; ok {
+		t.Error("ReachabilityPass left unreachable state \"dead\" in place")
+	}
+	for _, name := range []string{"start", "a"} {
+		if _, ok := m.States[name]; !ok {
+			t.Errorf("ReachabilityPass dropped reachable state %q", name)
+		}
+	}
+
+	if _, ok := m.Conditions["dead_sel"]; ok {
+		t.Error("ReachabilityPass left condition \"dead_sel\", referenced only by the unreachable state, in place")
+	}
+	if _, ok := m.Conditions["sel"]; !ok {
+		t.Error("ReachabilityPass dropped condition \"sel\", still referenced by a reachable transition")
+	}
+}
+
+func TestReachabilityPassUnknownFirstState(t *testing.T) {
+	m := &StateMachine{
+		Options: Options{FirstState: "missing"},
+		States:  map[string]State{"a": {}},
+	}
+	if err := ReachabilityPass(m); err == nil {
+		t.Error("ReachabilityPass with an unknown FirstState: want error, got nil")
+	}
+}
+
+func TestMinimizePass(t *testing.T) {
+	// a and b are equivalent: identical outputs and an identical
+	// unconditional transition back to start, so MinimizePass should merge
+	// them into their lexicographically smallest member, "a".
+	m := &StateMachine{
+		Options: Options{FirstState: "start"},
+		States: map[string]State{
+			"start": {Outputs: map[string]string{"y": "0"}, Transitions: []Transition{
+				{Condition: "sel", Next: "a"},
+				{Next: "b"},
+			}},
+			"a": {Outputs: map[string]string{"y": "1"}, Transitions: []Transition{{Next: "start"}}},
+			"b": {Outputs: map[string]string{"y": "1"}, Transitions: []Transition{{Next: "start"}}},
+		},
+	}
+
+	if err := MinimizePass(m); err != nil {
+		t.Fatalf("MinimizePass: %v", err)
+	}
+
+	if _, ok := m.States["b"]; ok {
+		t.Error("MinimizePass left equivalent state \"b\" unmerged")
+	}
+	if _, ok := m.States["a"]; !ok {
+		t.Error("MinimizePass dropped the canonical state \"a\"")
+	}
+	for _, name := range m.StateNames() {
+		for _, tr := range m.States[name].Transitions {
+			if tr.Next == "b" {
+				t.Errorf("state %q still transitions to merged-away state \"b\"", name)
+			}
+		}
+	}
+}
+
+func TestEncodePassWidths(t *testing.T) {
+	cases := []struct {
+		mode       string
+		numStates  int
+		wantWidth  int
+		wantOneHot bool
+	}{
+		{"binary", 3, 2, false},
+		{"gray", 5, 3, false},
+		{"one-hot", 4, 4, true},
+		{"", 2, 1, false}, // empty Encoding defaults to binary
+	}
+
+	for _, c := range cases {
+		m := &StateMachine{Options: Options{Encoding: c.mode}, States: map[string]State{}}
+		for i := 0; i < c.numStates; i++ {
+			m.States[string(rune('a'+i))] = State{}
+		}
+
+		if err := EncodePass(m); err != nil {
+			t.Fatalf("EncodePass(mode=%q): %v", c.mode, err)
+		}
+
+		for _, name := range m.StateNames() {
+			enc := m.States[name].Encoding
+			if len(enc) != c.wantWidth {
+				t.Errorf("mode=%q state %q: Encoding %q has width %d, want %d", c.mode, name, enc, len(enc), c.wantWidth)
+			}
+			if c.wantOneHot {
+				ones := 0
+				for _, b := range enc {
+					if b == '1' {
+						ones++
+					}
+				}
+				if ones != 1 {
+					t.Errorf("mode=%q state %q: Encoding %q has %d set bits, want exactly 1", c.mode, name, enc, ones)
+				}
+			}
+		}
+
+		if got := m.EncodingWidth(); got != c.wantWidth {
+			t.Errorf("mode=%q: EncodingWidth() = %d, want %d", c.mode, got, c.wantWidth)
+		}
+	}
+}
+
+func TestEncodePassUnknownMode(t *testing.T) {
+	m := &StateMachine{Options: Options{Encoding: "bogus"}, States: map[string]State{"a": {}}}
+	if err := EncodePass(m); err == nil {
+		t.Error("EncodePass with an unknown Options.Encoding: want error, got nil")
+	}
+}
+
+// simulateTrace walks m from Options.FirstState for len(selValues)+1 steps,
+// taking the "sel"-guarded transition out of a state when the matching
+// step's value is true and falling back to the unconditional transition
+// otherwise, and records a copy of each visited state's Outputs. It mirrors
+// enough of a clocked walk to check that a pass pipeline run preserves
+// observable behavior, without depending on any particular state's name
+// surviving minimization.
+func simulateTrace(m *StateMachine, selValues []bool) []map[string]string {
+	trace := make([]map[string]string, 0, len(selValues)+1)
+	name := m.Options.FirstState
+	for step := 0; ; step++ {
+		state, ok := m.States[name]
+		if !ok {
+			break
+		}
+		outputs := make(map[string]string, len(state.Outputs))
+		for k, v := range state.Outputs {
+			outputs[k] = v
+		}
+		trace = append(trace, outputs)
+
+		if step >= len(selValues) {
+			break
+		}
+		next := ""
+		fallback := ""
+		for _, tr := range state.Transitions {
+			if tr.Condition == "" {
+				fallback = tr.Next
+				continue
+			}
+			if tr.Condition == "sel" && selValues[step] {
+				next = tr.Next
+			}
+		}
+		if next == "" {
+			next = fallback
+		}
+		name = next
+	}
+	return trace
+}
+
+// TestTraceIdentityAcrossPasses checks that running the full default pass
+// pipeline (reachability, minimize, encode) doesn't change the sequence of
+// Moore outputs a clocked walk observes, even though it drops an unreachable
+// state and renames/merges equivalent ones out from under the walk.
+func TestTraceIdentityAcrossPasses(t *testing.T) {
+	build := func() *StateMachine {
+		return &StateMachine{
+			Options: Options{FirstState: "start", Encoding: "binary"},
+			States: map[string]State{
+				"start": {Outputs: map[string]string{"y": "0"}, Transitions: []Transition{
+					{Condition: "sel", Next: "a"},
+					{Next: "b"},
+				}},
+				"a":    {Outputs: map[string]string{"y": "1"}, Transitions: []Transition{{Next: "start"}}},
+				"b":    {Outputs: map[string]string{"y": "1"}, Transitions: []Transition{{Next: "start"}}},
+				"dead": {Outputs: map[string]string{"y": "9"}, Transitions: []Transition{{Next: "start"}}},
+			},
+		}
+	}
+
+	selValues := []bool{true, false, true, false}
+
+	before := build()
+	wantTrace := simulateTrace(before, selValues)
+
+	after := build()
+	if err := after.RunPasses(); err != nil {
+		t.Fatalf("RunPasses: %v", err)
+	}
+	gotTrace := simulateTrace(after, selValues)
+
+	if !reflect.DeepEqual(gotTrace, wantTrace) {
+		t.Errorf("trace after RunPasses = %v, want %v", gotTrace, wantTrace)
+	}
+
+	if _, ok := after.States["dead"]; ok {
+		t.Error("RunPasses left unreachable state \"dead\" in place")
+	}
+}
@@ -0,0 +1,115 @@
+package asmd
+
+import (
+	"bytes"
+	"strings"
+
+	apiv1 "github.com/sashalemon/asmd-gen/asmd/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// sniffFormat decides whether filename/contents describe JSON or a binary
+// protobuf StateMachine. The extension wins when recognized; otherwise we
+// fall back to sniffing the first non-whitespace byte, since a JSON
+// StateMachine always starts with '{'.
+func sniffFormat(filename string, contents []byte) string {
+	switch {
+	case strings.HasSuffix(filename, ".json"):
+		return "json"
+	case strings.HasSuffix(filename, ".pb"), strings.HasSuffix(filename, ".binpb"):
+		return "proto"
+	}
+
+	trimmed := bytes.TrimSpace(contents)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		return "json"
+	}
+	return "proto"
+}
+
+// FromProto converts a generated apiv1.StateMachine into the asmd package's
+// native StateMachine, the shape Validate/FixUpWithDefaults/RunPasses/Generate
+// all operate on. Exported for asmd/server, which receives apiv1 messages
+// off the wire.
+func FromProto(p *apiv1.StateMachine) *StateMachine {
+	m := &StateMachine{
+		Inputs:          variablesFromProto(p.GetInputs()),
+		Outputs:         variablesFromProto(p.GetOutputs()),
+		Parameters:      variablesFromProto(p.GetParameters()),
+		Registers:       variablesFromProto(p.GetRegisters()),
+		FunctionalUnits: make(map[string]FunctionalUnit, len(p.GetFunctionalUnits())),
+		States:          make(map[string]State, len(p.GetStates())),
+		Conditions:      make(map[string]Condition, len(p.GetConditions())),
+	}
+
+	opts := p.GetOptions()
+	m.Options = Options{
+		ModuleName:    opts.GetModuleName(),
+		ClockType:     opts.GetClockType(),
+		AddAsyncReset: protoBool(opts),
+		FirstState:    opts.GetFirstState(),
+		Indent:        opts.GetIndent(),
+		Author:        opts.GetAuthor(),
+		EnabledPasses: opts.GetEnabledPasses(),
+		Encoding:      opts.GetEncoding(),
+	}
+
+	for name, fu := range p.GetFunctionalUnits() {
+		m.FunctionalUnits[name] = FunctionalUnit{
+			Inputs:    variablesFromProto(fu.GetInputs()),
+			Outputs:   variablesFromProto(fu.GetOutputs()),
+			Registers: variablesFromProto(fu.GetRegisters()),
+		}
+	}
+
+	for name, s := range p.GetStates() {
+		transitions := make([]Transition, 0, len(s.GetTransitions()))
+		for _, t := range s.GetTransitions() {
+			transitions = append(transitions, Transition{Condition: t.GetCondition(), Next: t.GetNext()})
+		}
+		m.States[name] = State{
+			Outputs:     s.GetOutputs(),
+			Transitions: transitions,
+			Encoding:    s.GetEncoding(),
+		}
+	}
+
+	for name, c := range p.GetConditions() {
+		m.Conditions[name] = Condition{Expr: c.GetExpr()}
+	}
+
+	return m
+}
+
+// protoBool returns opts.AddAsyncReset as-is: nil when the client didn't
+// set the (now `optional`) field at all, so FixUpWithDefaults's "default
+// true" still fires for proto input the same way it does for JSON input
+// that omits the field, rather than every unset field silently meaning
+// "explicitly false".
+func protoBool(opts *apiv1.Options) *bool {
+	if opts == nil {
+		return nil
+	}
+	return opts.AddAsyncReset
+}
+
+func variablesFromProto(in map[string]*apiv1.Variable) map[string]Variable {
+	out := make(map[string]Variable, len(in))
+	for name, v := range in {
+		out[name] = Variable{
+			BitWidth:     v.GetBitWidth(),
+			Type:         v.GetType(),
+			DefaultValue: v.GetDefaultValue(),
+		}
+	}
+	return out
+}
+
+// parseProto unmarshals a binary-proto StateMachine from fileBytes.
+func parseProto(fileBytes []byte) (*StateMachine, error) {
+	p := new(apiv1.StateMachine)
+	if err := proto.Unmarshal(fileBytes, p); err != nil {
+		return nil, err
+	}
+	return FromProto(p), nil
+}
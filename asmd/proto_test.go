@@ -0,0 +1,30 @@
+package asmd
+
+import (
+	"testing"
+
+	apiv1 "github.com/sashalemon/asmd-gen/asmd/api/v1"
+)
+
+// TestFromProtoAddAsyncResetPresence checks that an unset add_async_reset
+// round-trips as nil (so FixUpWithDefaults's "default true" still fires),
+// the same as an absent field in the JSON path, rather than always coming
+// back as a non-nil false now that the field is `optional`.
+func TestFromProtoAddAsyncResetPresence(t *testing.T) {
+	unset := FromProto(&apiv1.StateMachine{Options: &apiv1.Options{}})
+	if unset.Options.AddAsyncReset != nil {
+		t.Errorf("AddAsyncReset for an unset field = %v, want nil", *unset.Options.AddAsyncReset)
+	}
+
+	falseVal := false
+	explicitFalse := FromProto(&apiv1.StateMachine{Options: &apiv1.Options{AddAsyncReset: &falseVal}})
+	if explicitFalse.Options.AddAsyncReset == nil || *explicitFalse.Options.AddAsyncReset != false {
+		t.Errorf("AddAsyncReset for an explicit false = %v, want non-nil false", explicitFalse.Options.AddAsyncReset)
+	}
+
+	trueVal := true
+	explicitTrue := FromProto(&apiv1.StateMachine{Options: &apiv1.Options{AddAsyncReset: &trueVal}})
+	if explicitTrue.Options.AddAsyncReset == nil || *explicitTrue.Options.AddAsyncReset != true {
+		t.Errorf("AddAsyncReset for an explicit true = %v, want non-nil true", explicitTrue.Options.AddAsyncReset)
+	}
+}
@@ -0,0 +1,131 @@
+// Package server implements the apiv1.Generator gRPC service on top of the
+// asmd package, so downstream tools can drive HDL generation without
+// shelling out to the CLI or writing JSON to disk.
+package server
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sashalemon/asmd-gen/asmd"
+	apiv1 "github.com/sashalemon/asmd-gen/asmd/api/v1"
+)
+
+// Generator implements apiv1.GeneratorServer.
+type Generator struct {
+	apiv1.UnimplementedGeneratorServer
+}
+
+func New() *Generator {
+	return &Generator{}
+}
+
+func (g *Generator) Validate(ctx context.Context, req *apiv1.StateMachine) (*apiv1.ValidationReport, error) {
+	m := asmd.FromProto(req)
+
+	report := &apiv1.ValidationReport{}
+	for _, issue := range m.Validate() {
+		report.Issues = append(report.Issues, &apiv1.ValidationIssue{
+			Severity: severityToProto(issue.Severity),
+			Path:     issue.Path,
+			Message:  issue.Message,
+		})
+	}
+	return report, nil
+}
+
+func severityToProto(s asmd.Severity) apiv1.Severity {
+	if s == asmd.SeverityError {
+		return apiv1.Severity_SEVERITY_ERROR
+	}
+	return apiv1.Severity_SEVERITY_WARN
+}
+
+func (g *Generator) Generate(ctx context.Context, req *apiv1.GenerateRequest) (*apiv1.GenerateResponse, error) {
+	m := asmd.FromProto(req.GetStateMachine())
+	m.FixUpWithDefaults()
+
+	if err := m.RunPasses(); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	tmpFile, err := generateToTempFile(m, req.GetTarget())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &apiv1.GenerateResponse{
+		Files: []*apiv1.GenerateResponse_File{
+			{Name: tmpFile.name, Contents: tmpFile.contents},
+		},
+	}, nil
+}
+
+func (g *Generator) GenerateStream(req *apiv1.GenerateRequest, stream apiv1.Generator_GenerateStreamServer) error {
+	m := asmd.FromProto(req.GetStateMachine())
+	m.FixUpWithDefaults()
+
+	for _, pass := range asmd.DefaultPasses {
+		if len(m.Options.EnabledPasses) > 0 {
+			break // a custom pipeline was requested; report it as one step below
+		}
+		err := m.RunPass(pass)
+		event := &apiv1.PassEvent{Pass: pass, Ok: err == nil}
+		if err != nil {
+			event.Message = err.Error()
+		}
+		if sendErr := stream.Send(event); sendErr != nil {
+			return sendErr
+		}
+		if err != nil {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+	}
+
+	if len(m.Options.EnabledPasses) > 0 {
+		if err := m.RunPasses(); err != nil {
+			stream.Send(&apiv1.PassEvent{Pass: "pipeline", Ok: false, Message: err.Error()})
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+		stream.Send(&apiv1.PassEvent{Pass: "pipeline", Ok: true})
+	}
+
+	tmpFile, err := generateToTempFile(m, req.GetTarget())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return stream.Send(&apiv1.PassEvent{Pass: fmt.Sprintf("emit:%s", req.GetTarget()), Ok: true, Message: tmpFile.name})
+}
+
+type generatedFile struct {
+	name     string
+	contents []byte
+}
+
+// generateToTempFile runs m.Generate(target, ...) against a scratch file
+// and reads the result back, since StateMachine.Generate writes to a path
+// rather than returning bytes directly.
+func generateToTempFile(m *asmd.StateMachine, target string) (*generatedFile, error) {
+	f, err := ioutil.TempFile("", "asmd-*."+target)
+	if err != nil {
+		return nil, err
+	}
+	name := f.Name()
+	f.Close()
+	defer os.Remove(name)
+
+	if err := m.Generate(target, name); err != nil {
+		return nil, err
+	}
+
+	contents, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	return &generatedFile{name: target + "." + target, contents: contents}, nil
+}
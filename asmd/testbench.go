@@ -0,0 +1,412 @@
+package asmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sort"
+)
+
+// stimulusStep is one node of the testbench's scripted walk: the state to
+// land in, and the condition (if any) that should drive the transition into
+// it from wherever the walk currently is.
+type stimulusStep struct {
+	State     string
+	Condition string // empty for the first step
+}
+
+// stimulusSequence walks every declared state exactly once via BFS over
+// Transitions, starting at Options.FirstState. It gives the testbench a
+// concrete, deterministic script: visit each state, assert its outputs,
+// then take whichever transition leads to the next not-yet-visited state.
+func (m *StateMachine) stimulusSequence() []stimulusStep {
+	if _, ok := m.States[m.Options.FirstState]; !ok {
+		return nil
+	}
+
+	visited := map[string]bool{m.Options.FirstState: true}
+	queue := []string{m.Options.FirstState}
+	seq := []stimulusStep{{State: m.Options.FirstState}}
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		for _, t := range m.States[name].Transitions {
+			if !visited[t.Next] {
+				visited[t.Next] = true
+				queue = append(queue, t.Next)
+				seq = append(seq, stimulusStep{State: t.Next, Condition: t.Condition})
+			}
+		}
+	}
+	return seq
+}
+
+// guardPattern matches an input compared explicitly to 0 or 1 in a
+// Condition.Expr, e.g. "go = '1'" or "go = '0'", and the "not (...)"/"!..."
+// negated forms of both.
+type guardPattern struct {
+	name        string
+	high        *regexp.Regexp
+	low         *regexp.Regexp
+	negatedHigh *regexp.Regexp
+	negatedLow  *regexp.Regexp
+}
+
+// compileGuardPatterns builds one guardPattern per input, reused across every
+// stimulusSequence step in a single Testbench call rather than recompiled
+// per step.
+func compileGuardPatterns(inputNames []string) []guardPattern {
+	patterns := make([]guardPattern, len(inputNames))
+	for i, name := range inputNames {
+		cmp := regexp.QuoteMeta(name) + `\b\s*=\s*'?`
+		neg := `(?:(?i:not)\s*|!)\s*\(?\s*`
+		patterns[i] = guardPattern{
+			name:        name,
+			high:        regexp.MustCompile(`\b` + cmp + `1'?\b`),
+			low:         regexp.MustCompile(`\b` + cmp + `0'?\b`),
+			negatedHigh: regexp.MustCompile(neg + `\b` + cmp + `1'?\b\)?`),
+			negatedLow:  regexp.MustCompile(neg + `\b` + cmp + `0'?\b\)?`),
+		}
+	}
+	return patterns
+}
+
+// conditionInputs returns, for each input explicitly compared against 0 or 1
+// in expr, whether driving it satisfies that comparison. This only covers
+// the common single-input-guard case ("name = '1'"/"name = '0'", and their
+// "not (...)"/"!..." negations); inputs mentioned without an explicit 0/1
+// comparison, or conditions built from registers/functional-unit outputs,
+// aren't drivable from the testbench and are left out rather than guessing
+// a polarity; the testbench prints a TODO comment only when none of a
+// condition's inputs is drivable.
+func conditionInputs(expr string, patterns []guardPattern) map[string]bool {
+	driven := map[string]bool{}
+	for _, p := range patterns {
+		switch {
+		case p.negatedHigh.MatchString(expr):
+			driven[p.name] = false
+		case p.negatedLow.MatchString(expr):
+			driven[p.name] = true
+		case p.high.MatchString(expr):
+			driven[p.name] = true
+		case p.low.MatchString(expr):
+			driven[p.name] = false
+		}
+	}
+	return driven
+}
+
+// guardableInputNames is inputNames minus clk/rst: the testbench drives
+// those two itself (clock generator, reset pulse), so they must never be
+// assigned again from the stimulus loop.
+func guardableInputNames(inputNames []string) []string {
+	out := make([]string, 0, len(inputNames))
+	for _, n := range inputNames {
+		if n == "clk" || n == "rst" {
+			continue
+		}
+		out = append(out, n)
+	}
+	return out
+}
+
+// Testbench emits a self-checking testbench for target ("vhdl" or
+// "verilog") that instantiates the DUT, drives clk/rst, walks every
+// declared state via stimulusSequence, and asserts each state's Moore
+// outputs as it goes. This gives the optimization passes something
+// concrete to regression-test against: the same FSM should produce an
+// identical trace before and after minimization/encoding.
+func (m *StateMachine) Testbench(target string, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	switch target {
+	case "vhdl":
+		return m.vhdlTestbench(file)
+	case "verilog", "sv":
+		return m.verilogTestbench(file)
+	default:
+		return errors.New("asmd: Testbench: unsupported target: " + target)
+	}
+}
+
+func (m *StateMachine) vhdlTestbench(w io.Writer) error {
+	var err error
+	tb := func(ss ...string) {
+		if err != nil {
+			return
+		}
+		for _, s := range ss {
+			if _, werr := io.WriteString(w, s); werr != nil {
+				err = werr
+				return
+			}
+		}
+	}
+
+	name := m.Options.TrimmedModuleName()
+	inputNames := sortedVariableNames(m.Inputs)
+	outputNames := sortedVariableNames(m.Outputs)
+
+	tb("library IEEE;\n")
+	tb("use IEEE.STD_LOGIC_1164.ALL;\n\n")
+	tb("entity ", name, "_tb is\n")
+	tb("end entity;\n\n")
+	tb("architecture sim of ", name, "_tb is\n\n")
+
+	for _, n := range inputNames {
+		tb(m.Indent(1), "signal ", n, " : std_logic := '0';\n")
+	}
+	for _, n := range outputNames {
+		tb(m.Indent(1), "signal ", n, " : std_logic;\n")
+	}
+	tb("\n")
+
+	tb("begin\n\n")
+	tb(m.Indent(1), "DUT: entity work.", name, "\n")
+	tb(m.Indent(2), "port map (\n")
+	ports := append(append([]string{}, inputNames...), outputNames...)
+	for i, n := range ports {
+		tb(m.Indent(3), n, " => ", n)
+		if i < len(ports)-1 {
+			tb(",")
+		}
+		tb("\n")
+	}
+	tb(m.Indent(2), ");\n\n")
+
+	tb(m.Indent(1), "clk <= not clk after 5 ns;\n\n")
+
+	tb(m.Indent(1), "stim: process\n")
+	tb(m.Indent(1), "begin\n")
+	tb(m.Indent(2), "rst <= '1';\n")
+	tb(m.Indent(2), "wait for 10 ns;\n")
+	tb(m.Indent(2), "rst <= '0';\n\n")
+
+	guardable := guardableInputNames(inputNames)
+	guardPatterns := compileGuardPatterns(guardable)
+	for _, step := range m.stimulusSequence() {
+		tb(m.Indent(2), "-- state ", step.State, "\n")
+		if step.Condition != "" {
+			expr := step.Condition
+			if c, ok := m.Conditions[step.Condition]; ok {
+				expr = c.Expr
+			}
+			driven := conditionInputs(expr, guardPatterns)
+			if len(driven) == 0 {
+				tb(m.Indent(2), "-- TODO: drive inputs so that (", expr, ") holds\n")
+			}
+			for _, n := range guardable {
+				val := "'0'"
+				if driven[n] {
+					val = "'1'"
+				}
+				tb(m.Indent(2), n, " <= ", val, ";\n")
+			}
+		}
+		tb(m.Indent(2), "wait until rising_edge(clk);\n")
+		for _, sig := range sortedMapKeys(m.States[step.State].Outputs) {
+			tb(m.Indent(2), "assert ", sig, " = ", m.States[step.State].Outputs[sig],
+				" report \"state ", step.State, ": unexpected ", sig, "\" severity error;\n")
+		}
+		tb("\n")
+	}
+
+	tb(m.Indent(2), "wait;\n")
+	tb(m.Indent(1), "end process;\n\n")
+	tb("end architecture;\n")
+
+	return err
+}
+
+func (m *StateMachine) verilogTestbench(w io.Writer) error {
+	var err error
+	tb := func(ss ...string) {
+		if err != nil {
+			return
+		}
+		for _, s := range ss {
+			if _, werr := io.WriteString(w, s); werr != nil {
+				err = werr
+				return
+			}
+		}
+	}
+
+	name := m.Options.TrimmedModuleName()
+	inputNames := sortedVariableNames(m.Inputs)
+	outputNames := sortedVariableNames(m.Outputs)
+
+	tb("module ", name, "_tb;\n\n")
+	for _, n := range inputNames {
+		tb(m.Indent(1), "reg ", n, " = 0;\n")
+	}
+	for _, n := range outputNames {
+		tb(m.Indent(1), "wire ", n, ";\n")
+	}
+	tb("\n")
+
+	tb(m.Indent(1), name, " dut (\n")
+	ports := append(append([]string{}, inputNames...), outputNames...)
+	for i, n := range ports {
+		tb(m.Indent(2), ".", n, "(", n, ")")
+		if i < len(ports)-1 {
+			tb(",")
+		}
+		tb("\n")
+	}
+	tb(m.Indent(1), ");\n\n")
+
+	tb(m.Indent(1), "initial clk = 0;\n")
+	tb(m.Indent(1), "always #5 clk = ~clk;\n\n")
+
+	tb(m.Indent(1), "initial begin\n")
+	tb(m.Indent(2), "rst = 1;\n")
+	tb(m.Indent(2), "#10;\n")
+	tb(m.Indent(2), "rst = 0;\n\n")
+
+	guardable := guardableInputNames(inputNames)
+	guardPatterns := compileGuardPatterns(guardable)
+	for _, step := range m.stimulusSequence() {
+		tb(m.Indent(2), "// state ", step.State, "\n")
+		if step.Condition != "" {
+			expr := step.Condition
+			if c, ok := m.Conditions[step.Condition]; ok {
+				expr = c.Expr
+			}
+			driven := conditionInputs(expr, guardPatterns)
+			if len(driven) == 0 {
+				tb(m.Indent(2), "// TODO: drive inputs so that (", expr, ") holds\n")
+			}
+			for _, n := range guardable {
+				val := "0"
+				if driven[n] {
+					val = "1"
+				}
+				tb(m.Indent(2), n, " = ", val, ";\n")
+			}
+		}
+		tb(m.Indent(2), "@(posedge clk);\n")
+		for _, sig := range sortedMapKeys(m.States[step.State].Outputs) {
+			expected := m.States[step.State].Outputs[sig]
+			tb(m.Indent(2), "if (", sig, " !== ", expected, ") $display(\"state ", step.State, ": unexpected ", sig, "\");\n")
+		}
+		tb("\n")
+	}
+
+	tb(m.Indent(2), "$finish;\n")
+	tb(m.Indent(1), "end\n\n")
+	tb("endmodule\n")
+
+	return err
+}
+
+// GTKWave emits a .gtkw savefile listing every input, output, and register
+// signal grouped by FunctionalUnit (plus a top-level group for the
+// module's own ports), so a generated waveform opens with sensible
+// groupings instead of one flat signal list.
+func (m *StateMachine) GTKWave(filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var werr error
+	gw := func(ss ...string) {
+		if werr != nil {
+			return
+		}
+		for _, s := range ss {
+			if _, e := io.WriteString(file, s); e != nil {
+				werr = e
+				return
+			}
+		}
+	}
+
+	gw("[*]\n")
+	gw("[*] asmd-generated savefile for ", m.Options.ModuleName, "\n")
+	gw("[dumpfile] \"", m.Options.TrimmedModuleName(), ".vcd\"\n")
+	gw("[timestart] 0\n")
+
+	gw("-", m.Options.ModuleName, "\n")
+	for _, n := range sortedVariableNames(m.Inputs) {
+		gw(m.Options.TrimmedModuleName(), ".", n, "\n")
+	}
+	for _, n := range sortedVariableNames(m.Outputs) {
+		gw(m.Options.TrimmedModuleName(), ".", n, "\n")
+	}
+
+	for _, unitName := range sortedFunctionalUnitNames(m.FunctionalUnits) {
+		unit := m.FunctionalUnits[unitName]
+		gw("-", unitName, "\n")
+		for _, n := range sortedVariableNames(unit.Inputs) {
+			gw(m.Options.TrimmedModuleName(), ".", unitName, ".", n, "\n")
+		}
+		for _, n := range sortedVariableNames(unit.Outputs) {
+			gw(m.Options.TrimmedModuleName(), ".", unitName, ".", n, "\n")
+		}
+		for _, n := range sortedVariableNames(unit.Registers) {
+			gw(m.Options.TrimmedModuleName(), ".", unitName, ".", n, "\n")
+		}
+	}
+
+	return werr
+}
+
+// SimMakefile emits a Makefile fragment with ghdl and iverilog targets for
+// simulating the module+testbench pair this package just generated.
+func (m *StateMachine) SimMakefile(filename string) error {
+	name := m.Options.TrimmedModuleName()
+	contents := fmt.Sprintf(`# Generated by asmd. Include from a parent Makefile, e.g.:
+#   include %[1]s.sim.mk
+
+.PHONY: sim-ghdl sim-iverilog
+
+sim-ghdl: %[1]s.vhdl %[1]s_tb.vhdl
+	ghdl -a %[1]s.vhdl %[1]s_tb.vhdl
+	ghdl -e %[1]s_tb
+	ghdl -r %[1]s_tb --wave=%[1]s.ghw
+
+sim-iverilog: %[1]s.v %[1]s_tb.v
+	iverilog -o %[1]s_tb.vvp %[1]s.v %[1]s_tb.v
+	vvp %[1]s_tb.vvp
+`, name)
+
+	return ioutil.WriteFile(filename, []byte(contents), 0644)
+}
+
+func sortedVariableNames(vars map[string]Variable) []string {
+	names := make([]string, 0, len(vars))
+	for n := range vars {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedFunctionalUnitNames(units map[string]FunctionalUnit) []string {
+	names := make([]string, 0, len(units))
+	for n := range units {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedMapKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
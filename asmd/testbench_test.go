@@ -0,0 +1,49 @@
+package asmd
+
+import "testing"
+
+func TestConditionInputs(t *testing.T) {
+	patterns := compileGuardPatterns([]string{"go", "btn", "count"})
+
+	cases := []struct {
+		expr string
+		want map[string]bool
+	}{
+		{"go = '1'", map[string]bool{"go": true}},
+		{"go = '0'", map[string]bool{"go": false}},
+		{"not (go = '1')", map[string]bool{"go": false}},
+		{"not(go = '1')", map[string]bool{"go": false}},
+		{"NOT (go = '1')", map[string]bool{"go": false}},
+		{"!go = '1'", map[string]bool{"go": false}},
+		{"not (go = '0')", map[string]bool{"go": true}},
+		{"go = '1' and btn = '0'", map[string]bool{"go": true, "btn": false}},
+		{"count = 10", map[string]bool{}},
+		{"not (count = 10)", map[string]bool{}},
+	}
+
+	for _, c := range cases {
+		got := conditionInputs(c.expr, patterns)
+		if len(got) != len(c.want) {
+			t.Errorf("conditionInputs(%q) = %v, want %v", c.expr, got, c.want)
+			continue
+		}
+		for name, want := range c.want {
+			if got[name] != want {
+				t.Errorf("conditionInputs(%q)[%q] = %v, want %v", c.expr, name, got[name], want)
+			}
+		}
+	}
+}
+
+func TestGuardableInputNames(t *testing.T) {
+	got := guardableInputNames([]string{"btn", "clk", "go", "rst"})
+	want := []string{"btn", "go"}
+	if len(got) != len(want) {
+		t.Fatalf("guardableInputNames = %v, want %v", got, want)
+	}
+	for i, name := range want {
+		if got[i] != name {
+			t.Errorf("guardableInputNames[%d] = %q, want %q", i, got[i], name)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Command asmd-server hosts the asmd Generator service over gRPC.
+//
+// An HTTP/JSON grpc-gateway front end is planned (see the NOTE in
+// asmd/api/v1/asmd.proto) but deferred until google/api is vendored for
+// protoc, so this only serves gRPC for now.
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+
+	"google.golang.org/grpc"
+
+	apiv1 "github.com/sashalemon/asmd-gen/asmd/api/v1"
+	"github.com/sashalemon/asmd-gen/asmd/server"
+
+	_ "github.com/sashalemon/asmd-gen/asmd/backend/sv"
+	_ "github.com/sashalemon/asmd-gen/asmd/backend/verilog"
+	_ "github.com/sashalemon/asmd-gen/asmd/backend/vhdl"
+)
+
+var grpcAddr = flag.String("grpc-addr", ":7300", "gRPC listen address")
+
+func main() {
+	flag.Parse()
+
+	lis, err := net.Listen("tcp", *grpcAddr)
+	if err != nil {
+		log.Fatalf("asmd-server: listen %s: %v", *grpcAddr, err)
+	}
+
+	grpcServer := grpc.NewServer()
+	apiv1.RegisterGeneratorServer(grpcServer, server.New())
+
+	log.Printf("asmd-server: gRPC listening on %s", *grpcAddr)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("asmd-server: serve: %v", err)
+	}
+}
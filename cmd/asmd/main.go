@@ -0,0 +1,98 @@
+// Command asmd reads a StateMachine description (JSON or proto) and emits
+// HDL for it, printing a validation summary along the way. -testbench,
+// -gtkwave, and -sim-makefile optionally emit the matching simulation
+// scaffolding alongside the HDL.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+
+	"github.com/sashalemon/asmd-gen/asmd"
+
+	_ "github.com/sashalemon/asmd-gen/asmd/backend/sv"
+	_ "github.com/sashalemon/asmd-gen/asmd/backend/verilog"
+	_ "github.com/sashalemon/asmd-gen/asmd/backend/vhdl"
+)
+
+func main() {
+	var (
+		input       = flag.String("in", "", "input StateMachine file (JSON or proto)")
+		output      = flag.String("out", "", "output HDL file")
+		target      = flag.String("target", "vhdl", "backend target: vhdl, verilog, sv")
+		debug       = flag.Bool("debug", false, "enable debug-level logging")
+		testbench   = flag.String("testbench", "", "optional self-checking testbench output file")
+		gtkwave     = flag.String("gtkwave", "", "optional GTKWave .gtkw savefile output")
+		simMakefile = flag.String("sim-makefile", "", "optional ghdl/iverilog Makefile fragment output")
+	)
+	flag.Parse()
+
+	if *input == "" || *output == "" {
+		fmt.Fprintln(os.Stderr, "usage: asmd -in <file> -out <file> [-target vhdl|verilog|sv] [-testbench <file>] [-gtkwave <file>] [-sim-makefile <file>]")
+		os.Exit(2)
+	}
+
+	minLevel := level.AllowInfo()
+	if *debug {
+		minLevel = level.AllowDebug()
+	}
+	logger := level.NewFilter(log.NewLogfmtLogger(os.Stderr), minLevel)
+
+	mac, err := asmd.Decode(*input)
+	if err != nil {
+		level.Error(logger).Log("msg", "decode failed", "file", *input, "err", err)
+		os.Exit(1)
+	}
+	mac.Logger = logger
+
+	issues := mac.Validate()
+	var warnCount, errCount int
+	for _, issue := range issues {
+		if issue.Severity == asmd.SeverityError {
+			errCount++
+		} else {
+			warnCount++
+		}
+	}
+	fmt.Fprintf(os.Stderr, "validate: %d warning(s), %d error(s)\n", warnCount, errCount)
+	if errCount > 0 {
+		os.Exit(1)
+	}
+
+	mac.FixUpWithDefaults()
+
+	if err := mac.RunPasses(); err != nil {
+		level.Error(logger).Log("msg", "pass failed", "err", err)
+		os.Exit(1)
+	}
+
+	if err := mac.Generate(*target, *output); err != nil {
+		level.Error(logger).Log("msg", "generate failed", "target", *target, "err", err)
+		os.Exit(1)
+	}
+
+	if *testbench != "" {
+		if err := mac.Testbench(*target, *testbench); err != nil {
+			level.Error(logger).Log("msg", "testbench failed", "target", *target, "err", err)
+			os.Exit(1)
+		}
+	}
+
+	if *gtkwave != "" {
+		if err := mac.GTKWave(*gtkwave); err != nil {
+			level.Error(logger).Log("msg", "gtkwave failed", "err", err)
+			os.Exit(1)
+		}
+	}
+
+	if *simMakefile != "" {
+		if err := mac.SimMakefile(*simMakefile); err != nil {
+			level.Error(logger).Log("msg", "sim-makefile failed", "err", err)
+			os.Exit(1)
+		}
+	}
+}